@@ -10,10 +10,11 @@ import (
 )
 
 var (
-	width  int
-	height int
-	cells  string
-	random bool
+	width   int
+	height  int
+	cells   string
+	random  bool
+	narrate bool
 )
 
 func main() {
@@ -30,6 +31,7 @@ func main() {
 	rootCmd.Flags().IntVarP(&height, "height", "y", 42, "Grid height")
 	rootCmd.Flags().StringVarP(&cells, "cells", "c", "[[1,0],[2,1],[0,2],[1,2],[2,2]]", "Start with live cells as JSON array: '[[x1,y1],[x2,y2],...]'")
 	rootCmd.Flags().BoolVarP(&random, "random", "r", false, "Randomize your start state")
+	rootCmd.Flags().BoolVarP(&narrate, "narrate", "n", false, "Describe each generation in words instead of drawing the grid")
 
 	if err := rootCmd.Execute(); err != nil {
 		log.Println(err)
@@ -67,12 +69,18 @@ func run(cmd *cobra.Command, args []string) {
 	// Game loop - continuously evolve and display
 	fmt.Println("Conway's Game of Life - Press Ctrl+C to exit")
 	
+	var previous *Grid
 	for generation := 0; ; generation++ {
 		// Display current generation
-		grid.MakeItSo()
-		fmt.Printf("Generation: %d\n", generation)
+		if narrate {
+			fmt.Println(grid.Narrate(generation, previous))
+		} else {
+			grid.MakeItSo()
+			fmt.Printf("Generation: %d\n", generation)
+		}
 		
 		// Calculate next generation
+		previous = grid
 		grid = grid.BoldlyGo()
 		
 		// Small delay to make it watchable
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// gliderDirections maps each 3x3 glider shape (as a 9-bit mask) to the way it travels
+var gliderDirections = buildGliderDirections()
+
+// buildGliderDirections evolves the southeast glider through its four phases
+// and mirrors each phase to cover the other three diagonals
+func buildGliderDirections() map[uint16]string {
+	directions := make(map[uint16]string)
+
+	// Start with the classic southeast glider in a roomy grid so it can't hit the walls
+	grid := NewGrid(8, 8)
+	for _, coord := range [][2]int{{1, 0}, {2, 1}, {0, 2}, {1, 2}, {2, 2}} {
+		grid.SetCell(coord[0]+1, coord[1]+1, 1)
+	}
+
+	for phase := 0; phase < 4; phase++ {
+		component := grid.findComponents()[0]
+		minX, minY, _, _ := boundingBox(component)
+
+		for _, mirror := range []struct {
+			flipX, flipY bool
+			direction    string
+		}{
+			{false, false, "southeast"},
+			{true, false, "southwest"},
+			{false, true, "northeast"},
+			{true, true, "northwest"},
+		} {
+			var mask uint16
+			for _, cell := range component {
+				x, y := cell[0]-minX, cell[1]-minY
+				if mirror.flipX {
+					x = 2 - x
+				}
+				if mirror.flipY {
+					y = 2 - y
+				}
+				mask |= 1 << uint(y*3+x)
+			}
+			directions[mask] = mirror.direction
+		}
+
+		grid = grid.BoldlyGo()
+	}
+
+	return directions
+}
+
+// Narrate describes a generation in plain words; previous may be nil for the first generation
+func (grid *Grid) Narrate(generation int, previous *Grid) string {
+	population := grid.Population()
+	parts := []string{fmt.Sprintf("gen %d: population %d", generation, population)}
+
+	if previous != nil {
+		switch delta := population - previous.Population(); {
+		case delta > 0:
+			parts[0] += fmt.Sprintf(", up %d", delta)
+		case delta < 0:
+			parts[0] += fmt.Sprintf(", down %d", -delta)
+		default:
+			parts[0] += ", no change"
+		}
+	}
+
+	if population == 0 {
+		parts = append(parts, "all life has died out")
+		return strings.Join(parts, "; ")
+	}
+
+	centerX, centerY := grid.centerOfActivity()
+	parts = append(parts, fmt.Sprintf("activity centered near %d,%d", centerX, centerY))
+
+	if previous != nil && grid.sameAs(previous) {
+		parts = append(parts, "the pattern is still")
+	}
+
+	parts = append(parts, grid.describeGliders()...)
+
+	return strings.Join(parts, "; ")
+}
+
+// Population counts the live cells on the grid
+func (grid *Grid) Population() int {
+	population := 0
+	for _, word := range grid.cells {
+		population += bits.OnesCount64(word)
+	}
+	return population
+}
+
+// centerOfActivity returns the average position of all live cells, rounded to the nearest cell
+func (grid *Grid) centerOfActivity() (int, int) {
+	sumX, sumY, population := 0, 0, 0
+	for y := 0; y < grid.height; y++ {
+		for x := 0; x < grid.width; x++ {
+			if grid.GetCell(x, y) == 1 {
+				sumX += x
+				sumY += y
+				population++
+			}
+		}
+	}
+	if population == 0 {
+		return 0, 0
+	}
+	return (sumX*2 + population) / (population * 2), (sumY*2 + population) / (population * 2)
+}
+
+// sameAs reports whether two grids hold exactly the same live cells
+func (grid *Grid) sameAs(other *Grid) bool {
+	if grid.width != other.width || grid.height != other.height {
+		return false
+	}
+	for i := range grid.cells {
+		if grid.cells[i] != other.cells[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// describeGliders finds gliders on the grid and says which way they're heading
+func (grid *Grid) describeGliders() []string {
+	counts := make(map[string]int)
+	for _, component := range grid.findComponents() {
+		if len(component) != 5 {
+			continue
+		}
+		minX, minY, maxX, maxY := boundingBox(component)
+		if maxX-minX != 2 || maxY-minY != 2 {
+			continue
+		}
+
+		var mask uint16
+		for _, cell := range component {
+			mask |= 1 << uint((cell[1]-minY)*3+(cell[0]-minX))
+		}
+		if direction, ok := gliderDirections[mask]; ok {
+			counts[direction]++
+		}
+	}
+
+	var descriptions []string
+	for _, direction := range []string{"northeast", "northwest", "southeast", "southwest"} {
+		switch count := counts[direction]; {
+		case count == 1:
+			descriptions = append(descriptions, fmt.Sprintf("a glider is moving %s", direction))
+		case count > 1:
+			descriptions = append(descriptions, fmt.Sprintf("%d gliders are moving %s", count, direction))
+		}
+	}
+	return descriptions
+}
+
+// findComponents groups live cells into clusters of cells that touch, diagonals included
+func (grid *Grid) findComponents() [][][2]int {
+	visited := make([]bool, grid.width*grid.height)
+	var components [][][2]int
+
+	for y := 0; y < grid.height; y++ {
+		for x := 0; x < grid.width; x++ {
+			if grid.GetCell(x, y) == 0 || visited[y*grid.width+x] {
+				continue
+			}
+
+			// Flood fill outward from this cell
+			var component [][2]int
+			stack := [][2]int{{x, y}}
+			visited[y*grid.width+x] = true
+			for len(stack) > 0 {
+				cell := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				component = append(component, cell)
+
+				for dy := -1; dy <= 1; dy++ {
+					for dx := -1; dx <= 1; dx++ {
+						newX, newY := cell[0]+dx, cell[1]+dy
+						if newX < 0 || newX >= grid.width || newY < 0 || newY >= grid.height {
+							continue
+						}
+						if grid.GetCell(newX, newY) == 1 && !visited[newY*grid.width+newX] {
+							visited[newY*grid.width+newX] = true
+							stack = append(stack, [2]int{newX, newY})
+						}
+					}
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	return components
+}
+
+// boundingBox returns the smallest rectangle containing every cell in the component
+func boundingBox(component [][2]int) (minX, minY, maxX, maxY int) {
+	minX, minY = component[0][0], component[0][1]
+	maxX, maxY = minX, minY
+	for _, cell := range component[1:] {
+		minX, maxX = min(minX, cell[0]), max(maxX, cell[0])
+		minY, maxY = min(minY, cell[1]), max(maxY, cell[1])
+	}
+	return
+}
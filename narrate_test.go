@@ -0,0 +1,171 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// gridWith builds a grid with the given cells alive
+func gridWith(width, height int, cells [][2]int) *Grid {
+	grid := NewGrid(width, height)
+	for _, cell := range cells {
+		grid.SetCell(cell[0], cell[1], 1)
+	}
+	return grid
+}
+
+// offset shifts every cell by dx,dy
+func offset(cells [][2]int, dx, dy int) [][2]int {
+	shifted := make([][2]int, len(cells))
+	for i, cell := range cells {
+		shifted[i] = [2]int{cell[0] + dx, cell[1] + dy}
+	}
+	return shifted
+}
+
+func TestGliderDirectionsHasEveryPhaseAndDiagonal(t *testing.T) {
+	// 4 phases x 4 diagonals, and no two of them share a shape
+	if len(gliderDirections) != 16 {
+		t.Fatalf("expected 16 glider shapes, got %d", len(gliderDirections))
+	}
+}
+
+func TestDescribeGlidersDirections(t *testing.T) {
+	tests := []struct {
+		direction string
+		cells     [][2]int
+		dx, dy    int
+	}{
+		{"southeast", [][2]int{{1, 0}, {2, 1}, {0, 2}, {1, 2}, {2, 2}}, 1, 1},
+		{"southwest", [][2]int{{1, 0}, {0, 1}, {2, 2}, {1, 2}, {0, 2}}, -1, 1},
+		{"northeast", [][2]int{{1, 2}, {2, 1}, {0, 0}, {1, 0}, {2, 0}}, 1, -1},
+		{"northwest", [][2]int{{1, 2}, {0, 1}, {2, 0}, {1, 0}, {0, 0}}, -1, -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.direction, func(t *testing.T) {
+			grid := gridWith(20, 20, offset(test.cells, 8, 8))
+			startX, startY := grid.centerOfActivity()
+
+			// Every phase along the way should carry the same label
+			for generation := 0; generation < 4; generation++ {
+				descriptions := grid.describeGliders()
+				want := "a glider is moving " + test.direction
+				if len(descriptions) != 1 || descriptions[0] != want {
+					t.Fatalf("generation %d: expected [%q], got %q", generation, want, descriptions)
+				}
+				grid = grid.BoldlyGo()
+			}
+
+			// After a full cycle the glider should have stepped one cell the way we said it would
+			endX, endY := grid.centerOfActivity()
+			if endX-startX != test.dx || endY-startY != test.dy {
+				t.Errorf("expected glider to move by %d,%d, moved by %d,%d", test.dx, test.dy, endX-startX, endY-startY)
+			}
+		})
+	}
+}
+
+func TestDescribeGlidersCountsSeveral(t *testing.T) {
+	glider := [][2]int{{1, 0}, {2, 1}, {0, 2}, {1, 2}, {2, 2}}
+	grid := gridWith(20, 20, append(offset(glider, 1, 1), offset(glider, 10, 10)...))
+
+	descriptions := grid.describeGliders()
+	if len(descriptions) != 1 || descriptions[0] != "2 gliders are moving southeast" {
+		t.Errorf("expected two southeast gliders, got %q", descriptions)
+	}
+}
+
+func TestDescribeGlidersIgnoresOtherShapes(t *testing.T) {
+	// A blinker and a block are neither of them gliders
+	grid := gridWith(20, 20, [][2]int{{2, 2}, {3, 2}, {4, 2}, {10, 10}, {11, 10}, {10, 11}, {11, 11}})
+
+	if descriptions := grid.describeGliders(); len(descriptions) != 0 {
+		t.Errorf("expected no gliders, got %q", descriptions)
+	}
+}
+
+func TestFindComponentsJoinsDiagonals(t *testing.T) {
+	grid := gridWith(10, 10, [][2]int{{0, 0}, {1, 1}, {2, 2}, {5, 5}})
+
+	components := grid.findComponents()
+	if len(components) != 2 {
+		t.Fatalf("expected 2 components, got %d", len(components))
+	}
+	if len(components[0]) != 3 || len(components[1]) != 1 {
+		t.Errorf("expected components of size 3 and 1, got %d and %d", len(components[0]), len(components[1]))
+	}
+}
+
+func TestNarrateEmptyGrid(t *testing.T) {
+	narration := NewGrid(10, 10).Narrate(0, nil)
+
+	if narration != "gen 0: population 0; all life has died out" {
+		t.Errorf("unexpected narration: %q", narration)
+	}
+}
+
+func TestNarrateStillLife(t *testing.T) {
+	block := gridWith(10, 10, [][2]int{{4, 4}, {5, 4}, {4, 5}, {5, 5}})
+	narration := block.BoldlyGo().Narrate(1, block)
+
+	if !strings.Contains(narration, "the pattern is still") {
+		t.Errorf("expected a block to be still, got %q", narration)
+	}
+	if !strings.Contains(narration, "population 4, no change") {
+		t.Errorf("expected a steady population, got %q", narration)
+	}
+}
+
+func TestNarratePopulationDeltas(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous [][2]int
+		current  [][2]int
+		want     string
+	}{
+		{"up", [][2]int{{0, 0}}, [][2]int{{0, 0}, {5, 5}, {9, 9}}, "gen 1: population 3, up 2;"},
+		{"down", [][2]int{{0, 0}, {5, 5}, {9, 9}}, [][2]int{{0, 0}}, "gen 1: population 1, down 2;"},
+		{"no change", [][2]int{{0, 0}}, [][2]int{{9, 9}}, "gen 1: population 1, no change;"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			previous := gridWith(10, 10, test.previous)
+			narration := gridWith(10, 10, test.current).Narrate(1, previous)
+
+			if !strings.HasPrefix(narration, test.want) {
+				t.Errorf("expected prefix %q, got %q", test.want, narration)
+			}
+		})
+	}
+}
+
+func TestNarrateFirstGenerationHasNoDelta(t *testing.T) {
+	narration := gridWith(10, 10, [][2]int{{3, 4}}).Narrate(0, nil)
+
+	if narration != "gen 0: population 1; activity centered near 3,4" {
+		t.Errorf("unexpected narration: %q", narration)
+	}
+}
+
+func TestCenterOfActivityRounding(t *testing.T) {
+	tests := []struct {
+		name         string
+		cells        [][2]int
+		wantX, wantY int
+	}{
+		{"halfway rounds up", [][2]int{{0, 0}, {1, 0}}, 1, 0},
+		{"below half rounds down", [][2]int{{0, 0}, {0, 3}, {1, 0}}, 0, 1},
+		{"exact", [][2]int{{2, 6}, {4, 8}}, 3, 7},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			x, y := gridWith(10, 10, test.cells).centerOfActivity()
+			if x != test.wantX || y != test.wantY {
+				t.Errorf("expected %d,%d, got %d,%d", test.wantX, test.wantY, x, y)
+			}
+		})
+	}
+}